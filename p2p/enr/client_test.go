@@ -0,0 +1,102 @@
+// Copyright 2023 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package enr
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+func TestNewClient(t *testing.T) {
+	c, err := NewClient("geth", "1.13.0", "linux-amd64")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	if c.Name() != "geth" || c.Version() != "1.13.0" || c.Build() != "linux-amd64" {
+		t.Fatalf("unexpected client fields: %+v", c)
+	}
+	if want := "geth/1.13.0/linux-amd64"; c.String() != want {
+		t.Fatalf("String() = %q, want %q", c.String(), want)
+	}
+
+	c2, err := NewClient("geth", "1.13.0")
+	if err != nil {
+		t.Fatalf("NewClient without build: %v", err)
+	}
+	if want := "geth/1.13.0"; c2.String() != want {
+		t.Fatalf("String() = %q, want %q", c2.String(), want)
+	}
+}
+
+func TestNewClientInvalid(t *testing.T) {
+	tests := []struct {
+		name, version string
+		build         []string
+	}{
+		{"", "1.0.0", nil},
+		{"geth", "", nil},
+		{"geth,lighthouse", "1.0.0", nil},
+		{"geth;rm -rf", "1.0.0", nil},
+		{"geth", "1.0.0", []string{"a", "b"}},
+		{strings.Repeat("x", maxClientFieldLen+1), "1.0.0", nil},
+		{"g\x01th", "1.0.0", nil},
+	}
+	for i, tc := range tests {
+		if _, err := NewClient(tc.name, tc.version, tc.build...); err == nil {
+			t.Errorf("test %d: expected error for name=%q version=%q build=%v", i, tc.name, tc.version, tc.build)
+		}
+	}
+}
+
+func TestClientRoundtrip(t *testing.T) {
+	want, err := NewClient("geth", "1.13.0", "linux-amd64")
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc, err := rlp.EncodeToBytes(want)
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	var got Client
+	if err := rlp.DecodeBytes(enc, &got); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if got.String() != want.String() {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestClientDecodeMalformed(t *testing.T) {
+	tests := [][]string{
+		{"onlyname"},
+		{"a", "b", "c", "d"},
+		{"geth,evil", "1.0.0"},
+		{"", "1.0.0"},
+	}
+	for i, list := range tests {
+		enc, err := rlp.EncodeToBytes(list)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var v Client
+		if err := rlp.DecodeBytes(enc, &v); err == nil {
+			t.Errorf("test %d: expected error decoding %v", i, list)
+		}
+	}
+}
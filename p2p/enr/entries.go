@@ -88,6 +88,9 @@ type QUIC6 uint16
 func (v QUIC6) ENRKey() string { return "quic6" }
 
 // ID is the "id" key, which holds the name of the identity scheme.
+//
+// The identity scheme named by this entry determines how a record's signature is
+// produced and verified. See IdentityScheme and RegisterIdentityScheme.
 type ID string
 
 const IDv4 = ID("v4") // the default identity scheme
@@ -153,9 +156,88 @@ func (v *IPv4) DecodeRLP(s *rlp.Stream) error {
 	return nil
 }
 
-// Client is the "client" key, which holds the EIP-7636 client info.
+// Client is the "client" key, which holds the EIP-7636 client info: name, version and an
+// optional build identifier. Use NewClient to construct a valid value; the zero value is
+// not valid.
 type Client [3]*string
 
+// maxClientFieldLen is the maximum length, in bytes, of each Client field per EIP-7636.
+const maxClientFieldLen = 255
+
+// NewClient creates a Client entry, validating each field against EIP-7636: every field
+// must be printable ASCII, must not contain a comma or semicolon (the separators used
+// when client info is rendered as text elsewhere), and must be between 1 and
+// maxClientFieldLen bytes long. build is optional; at most one value may be given.
+func NewClient(name, version string, build ...string) (Client, error) {
+	if len(build) > 1 {
+		return Client{}, fmt.Errorf("enr: too many client build identifiers, want at most 1, got %d", len(build))
+	}
+	if err := validateClientField("name", name); err != nil {
+		return Client{}, err
+	}
+	if err := validateClientField("version", version); err != nil {
+		return Client{}, err
+	}
+	var v Client
+	v[0], v[1] = &name, &version
+	if len(build) == 1 {
+		if err := validateClientField("build", build[0]); err != nil {
+			return Client{}, err
+		}
+		v[2] = &build[0]
+	}
+	return v, nil
+}
+
+func validateClientField(field, s string) error {
+	if len(s) == 0 || len(s) > maxClientFieldLen {
+		return fmt.Errorf("enr: client %s must be between 1 and %d bytes, got %d", field, maxClientFieldLen, len(s))
+	}
+	for _, r := range s {
+		if r < 0x20 || r > 0x7e {
+			return fmt.Errorf("enr: client %s contains non-printable character %q", field, r)
+		}
+		if r == ',' || r == ';' {
+			return fmt.Errorf("enr: client %s must not contain %q", field, r)
+		}
+	}
+	return nil
+}
+
+// Name returns the client name, or the empty string if unset.
+func (v Client) Name() string {
+	if v[0] == nil {
+		return ""
+	}
+	return *v[0]
+}
+
+// Version returns the client version, or the empty string if unset.
+func (v Client) Version() string {
+	if v[1] == nil {
+		return ""
+	}
+	return *v[1]
+}
+
+// Build returns the client build identifier, or the empty string if unset.
+func (v Client) Build() string {
+	if v[2] == nil {
+		return ""
+	}
+	return *v[2]
+}
+
+// String returns the canonical "name/version/build" form used for logging, matching how
+// node-info endpoints expose client identity. The build segment is omitted when unset.
+func (v Client) String() string {
+	s := v.Name() + "/" + v.Version()
+	if b := v.Build(); b != "" {
+		s += "/" + b
+	}
+	return s
+}
+
 func (v Client) ENRKey() string { return "client" }
 
 // EncodeRLP implements rlp.Encoder.
@@ -169,6 +251,17 @@ func (v Client) EncodeRLP(w io.Writer) error {
 	if len(list) < 2 || len(list) > 3 {
 		return fmt.Errorf("invalid client info length: %d", len(list))
 	}
+	for i, s := range list {
+		field := "name"
+		if i == 1 {
+			field = "version"
+		} else if i == 2 {
+			field = "build"
+		}
+		if err := validateClientField(field, s); err != nil {
+			return err
+		}
+	}
 	return rlp.Encode(w, list)
 }
 
@@ -182,6 +275,15 @@ func (v *Client) DecodeRLP(s *rlp.Stream) error {
 		return fmt.Errorf("invalid client info length: %d", len(list))
 	}
 	for i := 0; i < len(list); i++ {
+		field := "name"
+		if i == 1 {
+			field = "version"
+		} else if i == 2 {
+			field = "build"
+		}
+		if err := validateClientField(field, list[i]); err != nil {
+			return err
+		}
 		str := list[i]
 		v[i] = &str
 	}
@@ -0,0 +1,156 @@
+// Copyright 2023 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package enr
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// enrForkIDSize is the SSZ-encoded size of ENRForkID: fork_digest (4 bytes),
+// next_fork_version (4 bytes) and next_fork_epoch (8 bytes, little-endian).
+const enrForkIDSize = 4 + 4 + 8
+
+// ENRForkID is the beacon-chain fork identifier carried by the "eth2" ENR entry. It is
+// SSZ-encoded as a fixed-size container before being wrapped in an RLP byte string, the
+// same layout consensus clients publish.
+type ENRForkID struct {
+	CurrentForkDigest [4]byte
+	NextForkVersion   [4]byte
+	NextForkEpoch     uint64
+}
+
+// Eth2 is the "eth2" key, which holds the beacon-chain fork digest of the node.
+type Eth2 ENRForkID
+
+func (v Eth2) ENRKey() string { return "eth2" }
+
+// EncodeRLP implements rlp.Encoder. It SSZ-encodes the fork ID and wraps the result in
+// an RLP byte string, matching how beacon nodes publish the "eth2" key.
+func (v Eth2) EncodeRLP(w io.Writer) error {
+	var buf [enrForkIDSize]byte
+	copy(buf[0:4], v.CurrentForkDigest[:])
+	copy(buf[4:8], v.NextForkVersion[:])
+	binary.LittleEndian.PutUint64(buf[8:16], v.NextForkEpoch)
+	return rlp.Encode(w, buf[:])
+}
+
+// DecodeRLP implements rlp.Decoder.
+func (v *Eth2) DecodeRLP(s *rlp.Stream) error {
+	buf, err := s.Bytes()
+	if err != nil {
+		return err
+	}
+	if len(buf) != enrForkIDSize {
+		return fmt.Errorf("invalid eth2 fork id, want %d bytes: %v", enrForkIDSize, buf)
+	}
+	copy(v.CurrentForkDigest[:], buf[0:4])
+	copy(v.NextForkVersion[:], buf[4:8])
+	v.NextForkEpoch = binary.LittleEndian.Uint64(buf[8:16])
+	return nil
+}
+
+// Attnets is the "attnets" key, a bitfield of the beacon attestation subnets the node
+// participates in.
+type Attnets [8]byte
+
+func (v Attnets) ENRKey() string { return "attnets" }
+
+// EncodeRLP implements rlp.Encoder.
+func (v Attnets) EncodeRLP(w io.Writer) error {
+	return rlp.Encode(w, v[:])
+}
+
+// DecodeRLP implements rlp.Decoder.
+func (v *Attnets) DecodeRLP(s *rlp.Stream) error {
+	buf, err := s.Bytes()
+	if err != nil {
+		return err
+	}
+	if len(buf) != len(v) {
+		return fmt.Errorf("invalid attnets bitfield, want %d bytes: %v", len(v), buf)
+	}
+	copy(v[:], buf)
+	return nil
+}
+
+// Has reports whether the bit for the given attestation subnet is set.
+func (v Attnets) Has(subnet uint64) bool {
+	return hasBit(v[:], subnet)
+}
+
+// Set sets or clears the bit for the given attestation subnet.
+func (v *Attnets) Set(subnet uint64, on bool) {
+	setBit(v[:], subnet, on)
+}
+
+// Syncnets is the "syncnets" key, a bitfield of the beacon sync committee subnets the
+// node participates in.
+type Syncnets [1]byte
+
+func (v Syncnets) ENRKey() string { return "syncnets" }
+
+// EncodeRLP implements rlp.Encoder.
+func (v Syncnets) EncodeRLP(w io.Writer) error {
+	return rlp.Encode(w, v[:])
+}
+
+// DecodeRLP implements rlp.Decoder.
+func (v *Syncnets) DecodeRLP(s *rlp.Stream) error {
+	buf, err := s.Bytes()
+	if err != nil {
+		return err
+	}
+	if len(buf) != len(v) {
+		return fmt.Errorf("invalid syncnets bitfield, want %d bytes: %v", len(v), buf)
+	}
+	copy(v[:], buf)
+	return nil
+}
+
+// Has reports whether the bit for the given sync committee subnet is set.
+func (v Syncnets) Has(subnet uint64) bool {
+	return hasBit(v[:], subnet)
+}
+
+// Set sets or clears the bit for the given sync committee subnet.
+func (v *Syncnets) Set(subnet uint64, on bool) {
+	setBit(v[:], subnet, on)
+}
+
+func hasBit(bitfield []byte, bit uint64) bool {
+	idx := bit / 8
+	if idx >= uint64(len(bitfield)) {
+		return false
+	}
+	return bitfield[idx]&(1<<(bit%8)) != 0
+}
+
+func setBit(bitfield []byte, bit uint64, on bool) {
+	idx := bit / 8
+	if idx >= uint64(len(bitfield)) {
+		return
+	}
+	if on {
+		bitfield[idx] |= 1 << (bit % 8)
+	} else {
+		bitfield[idx] &^= 1 << (bit % 8)
+	}
+}
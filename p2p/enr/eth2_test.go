@@ -0,0 +1,118 @@
+// Copyright 2023 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package enr
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+func TestEth2Roundtrip(t *testing.T) {
+	want := Eth2{
+		CurrentForkDigest: [4]byte{0x01, 0x02, 0x03, 0x04},
+		NextForkVersion:   [4]byte{0x05, 0x06, 0x07, 0x08},
+		NextForkEpoch:     12345,
+	}
+	enc, err := rlp.EncodeToBytes(want)
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	var got Eth2
+	if err := rlp.DecodeBytes(enc, &got); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if got != want {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestEth2DecodeWrongSize(t *testing.T) {
+	enc, _ := rlp.EncodeToBytes([]byte{1, 2, 3})
+	var v Eth2
+	if err := rlp.DecodeBytes(enc, &v); err == nil {
+		t.Fatalf("expected error decoding too-short eth2 entry")
+	}
+
+	enc, _ = rlp.EncodeToBytes(make([]byte, enrForkIDSize+1))
+	if err := rlp.DecodeBytes(enc, &v); err == nil {
+		t.Fatalf("expected error decoding too-long eth2 entry")
+	}
+}
+
+func TestAttnetsRoundtrip(t *testing.T) {
+	var want Attnets
+	want.Set(3, true)
+	want.Set(17, true)
+
+	enc, err := rlp.EncodeToBytes(want)
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	var got Attnets
+	if err := rlp.DecodeBytes(enc, &got); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if got != want {
+		t.Fatalf("got %x, want %x", got, want)
+	}
+	if !got.Has(3) || !got.Has(17) {
+		t.Fatalf("expected subnets 3 and 17 to be set")
+	}
+	if got.Has(4) {
+		t.Fatalf("subnet 4 should not be set")
+	}
+
+	got.Set(3, false)
+	if got.Has(3) {
+		t.Fatalf("subnet 3 should have been cleared")
+	}
+}
+
+func TestAttnetsDecodeWrongSize(t *testing.T) {
+	enc, _ := rlp.EncodeToBytes(bytes.Repeat([]byte{0}, 7))
+	var v Attnets
+	if err := rlp.DecodeBytes(enc, &v); err == nil {
+		t.Fatalf("expected error decoding undersized attnets bitfield")
+	}
+}
+
+func TestSyncnetsRoundtrip(t *testing.T) {
+	var want Syncnets
+	want.Set(2, true)
+
+	enc, err := rlp.EncodeToBytes(want)
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	var got Syncnets
+	if err := rlp.DecodeBytes(enc, &got); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if !got.Has(2) {
+		t.Fatalf("expected subnet 2 to be set")
+	}
+}
+
+func TestSyncnetsDecodeWrongSize(t *testing.T) {
+	enc, _ := rlp.EncodeToBytes([]byte{0, 0})
+	var v Syncnets
+	if err := rlp.DecodeBytes(enc, &v); err == nil {
+		t.Fatalf("expected error decoding oversized syncnets bitfield")
+	}
+}
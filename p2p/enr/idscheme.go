@@ -0,0 +1,245 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package enr
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"golang.org/x/crypto/sha3"
+)
+
+// ErrInvalidSig is returned by IdentityScheme.Verify when a record's signature does not
+// check out.
+var ErrInvalidSig = errors.New("invalid signature")
+
+// IdentityScheme is implemented by identity schemes, i.e. the set of algorithms used to
+// sign and verify node records. EIP-1049 reserves the "id" key for the scheme name so
+// records from unknown schemes can still be parsed and re-encoded without understanding
+// their signature.
+type IdentityScheme interface {
+	Verify(r *Record, sig []byte) error
+	NodeAddr(r *Record) []byte
+}
+
+// SchemeMap is a registry of named identity schemes.
+type SchemeMap map[string]IdentityScheme
+
+// Verify implements IdentityScheme, dispatching to the scheme named by the record's "id"
+// entry.
+func (m SchemeMap) Verify(r *Record, sig []byte) error {
+	s := m[r.IdentityScheme()]
+	if s == nil {
+		return ErrInvalidSig
+	}
+	return s.Verify(r, sig)
+}
+
+// NodeAddr implements IdentityScheme, dispatching to the scheme named by the record's
+// "id" entry.
+func (m SchemeMap) NodeAddr(r *Record) []byte {
+	s := m[r.IdentityScheme()]
+	if s == nil {
+		return nil
+	}
+	return s.NodeAddr(r)
+}
+
+// schemesMu guards ValidSchemes, which is read on every call to VerifySignature and
+// NodeAddrFor and may be mutated at any time by RegisterIdentityScheme.
+var schemesMu sync.RWMutex
+
+// ValidSchemes holds the identity schemes that VerifySignature, NodeAddrFor and SignV4 trust
+// for records received from real, potentially adversarial peers. It does NOT include the
+// "null" scheme, which accepts any signature and must never be reachable from real peer
+// traffic; use ValidSchemesForTesting for that. RegisterIdentityScheme extends this map.
+// Access must go through schemesMu, VerifySignature or NodeAddrFor.
+var ValidSchemes = SchemeMap{
+	"v4":       V4ID,
+	"v4compat": V4CompatID,
+}
+
+// ValidSchemesForTesting is like ValidSchemes but also accepts the "null" scheme. It must
+// only be used in tests that construct records without real signatures.
+var ValidSchemesForTesting = SchemeMap{
+	"v4":       V4ID,
+	"v4compat": V4CompatID,
+	"null":     NullID,
+}
+
+// RegisterIdentityScheme adds a named identity scheme to ValidSchemes, the default scheme
+// registry used by VerifySignature and SignV4. This lets code outside this package (for
+// example beacon-chain discovery tooling) plug in custom "id" values. It panics if name
+// is already registered.
+func RegisterIdentityScheme(name string, s IdentityScheme) {
+	schemesMu.Lock()
+	defer schemesMu.Unlock()
+	if _, exists := ValidSchemes[name]; exists {
+		panic(fmt.Sprintf("enr: identity scheme %q already registered", name))
+	}
+	ValidSchemes[name] = s
+}
+
+// VerifySignature verifies sig against r using ValidSchemes, synchronized against
+// concurrent calls to RegisterIdentityScheme.
+func VerifySignature(r *Record, sig []byte) error {
+	schemesMu.RLock()
+	defer schemesMu.RUnlock()
+	return ValidSchemes.Verify(r, sig)
+}
+
+// NodeAddrFor returns the node address of r under ValidSchemes, synchronized against
+// concurrent calls to RegisterIdentityScheme.
+func NodeAddrFor(r *Record) []byte {
+	schemesMu.RLock()
+	defer schemesMu.RUnlock()
+	return ValidSchemes.NodeAddr(r)
+}
+
+// Secp256k1 is the "secp256k1" key, which holds a public key.
+type Secp256k1 ecdsa.PublicKey
+
+func (v Secp256k1) ENRKey() string { return "secp256k1" }
+
+// EncodeRLP implements rlp.Encoder.
+func (v Secp256k1) EncodeRLP(w io.Writer) error {
+	return rlp.Encode(w, crypto.CompressPubkey((*ecdsa.PublicKey)(&v)))
+}
+
+// DecodeRLP implements rlp.Decoder.
+func (v *Secp256k1) DecodeRLP(s *rlp.Stream) error {
+	buf, err := s.Bytes()
+	if err != nil {
+		return err
+	}
+	pk, err := crypto.DecompressPubkey(buf)
+	if err != nil {
+		return err
+	}
+	*v = (Secp256k1)(*pk)
+	return nil
+}
+
+// s256raw is an unparsed secp256k1 public key entry.
+type s256raw []byte
+
+func (s256raw) ENRKey() string { return "secp256k1" }
+
+// v4ID is the "v4" identity scheme.
+//
+// This is the default scheme for Ethereum node records. Use SignV4 to create a record
+// that uses this scheme, and V4ID (or the default registry) to verify it.
+type v4ID struct{}
+
+// V4ID is an implementation of the "v4" identity scheme.
+var V4ID IdentityScheme = v4ID{}
+
+// SignV4 signs a record using the "v4" identity scheme.
+func SignV4(r *Record, privkey *ecdsa.PrivateKey) error {
+	// Copy r to avoid modifying it if signing fails.
+	cpy := *r
+	cpy.Set(ID("v4"))
+	cpy.Set(Secp256k1(privkey.PublicKey))
+
+	h := sha3.NewLegacyKeccak256()
+	rlp.Encode(h, cpy.appendElements(nil))
+	sig, err := crypto.Sign(h.Sum(nil), privkey)
+	if err != nil {
+		return err
+	}
+	sig = sig[:len(sig)-1] // remove recovery id
+
+	schemesMu.RLock()
+	err = cpy.SetSig(ValidSchemes, sig)
+	schemesMu.RUnlock()
+	if err == nil {
+		*r = cpy
+	}
+	return err
+}
+
+func (v4ID) Verify(r *Record, sig []byte) error {
+	var entry s256raw
+	if err := r.Load(&entry); err != nil {
+		return err
+	} else if len(entry) != 33 {
+		return fmt.Errorf("invalid public key")
+	}
+
+	h := sha3.NewLegacyKeccak256()
+	rlp.Encode(h, r.appendElements(nil))
+	if !crypto.VerifySignature(entry, h.Sum(nil), sig) {
+		return ErrInvalidSig
+	}
+	return nil
+}
+
+func (v4ID) NodeAddr(r *Record) []byte {
+	var pubkey Secp256k1
+	err := r.Load(&pubkey)
+	if err != nil {
+		return nil
+	}
+	buf := make([]byte, 64)
+	math.ReadBits(pubkey.X, buf[:32])
+	math.ReadBits(pubkey.Y, buf[32:])
+	return crypto.Keccak256(buf)
+}
+
+// v4CompatID is the "v4compat" identity scheme. It verifies like "v4", except that
+// records carrying no signature at all are accepted as-is. This exists to load legacy,
+// pre-EIP-778 node records that were never signed.
+type v4CompatID struct{}
+
+// V4CompatID is an implementation of the "v4compat" identity scheme.
+var V4CompatID IdentityScheme = v4CompatID{}
+
+func (v4CompatID) Verify(r *Record, sig []byte) error {
+	if len(sig) == 0 {
+		return nil
+	}
+	return V4ID.Verify(r, sig)
+}
+
+func (v4CompatID) NodeAddr(r *Record) []byte {
+	return V4ID.NodeAddr(r)
+}
+
+// nullID is the "null" identity scheme. It verifies all records as valid and uses the
+// empty node address for every record. It exists so tests can construct records without
+// dealing with real signatures, and must never be added to ValidSchemes: a record
+// received from a real peer could set "id: null" to bypass signature verification
+// entirely.
+type nullID struct{}
+
+// NullID is an implementation of the "null" identity scheme, useful for testing. It is
+// registered in ValidSchemesForTesting only, never in ValidSchemes.
+var NullID IdentityScheme = nullID{}
+
+func (nullID) Verify(r *Record, sig []byte) error {
+	return nil
+}
+
+func (nullID) NodeAddr(r *Record) []byte {
+	return nil
+}
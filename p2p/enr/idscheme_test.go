@@ -0,0 +1,129 @@
+// Copyright 2023 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package enr
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// TestSignV4Roundtrip checks that a v4-signed record verifies against the default scheme
+// registry and reports the expected node address.
+func TestSignV4Roundtrip(t *testing.T) {
+	privkey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var r Record
+	r.Set(UDP(30303))
+	if err := SignV4(&r, privkey); err != nil {
+		t.Fatalf("SignV4: %v", err)
+	}
+	if err := VerifySignature(&r, r.Signature()); err != nil {
+		t.Fatalf("VerifySignature: %v", err)
+	}
+
+	wantAddr := V4ID.NodeAddr(&r)
+	if addr := NodeAddrFor(&r); string(addr) != string(wantAddr) {
+		t.Fatalf("NodeAddr mismatch: got %x, want %x", addr, wantAddr)
+	}
+}
+
+// TestValidSchemesExcludesNull checks that the "null" scheme, which accepts any
+// signature, is only reachable through ValidSchemesForTesting and never through the
+// default ValidSchemes registry used for real peer traffic.
+func TestValidSchemesExcludesNull(t *testing.T) {
+	if _, ok := ValidSchemes["null"]; ok {
+		t.Fatalf("ValidSchemes must not contain the null scheme")
+	}
+	if _, ok := ValidSchemesForTesting["null"]; !ok {
+		t.Fatalf("ValidSchemesForTesting must contain the null scheme")
+	}
+
+	var r Record
+	r.Set(ID("null"))
+	r.Set(UDP(30303))
+	if err := VerifySignature(&r, nil); err == nil {
+		t.Fatalf("VerifySignature must reject an \"id: null\" record from the default registry")
+	}
+	if err := ValidSchemesForTesting.Verify(&r, nil); err != nil {
+		t.Fatalf("ValidSchemesForTesting should accept a null-scheme record: %v", err)
+	}
+}
+
+// TestV4CompatIDUnsigned checks that the "v4compat" scheme accepts a record with no
+// signature, unlike plain "v4".
+func TestV4CompatIDUnsigned(t *testing.T) {
+	var r Record
+	r.Set(ID("v4compat"))
+	r.Set(UDP(30303))
+
+	if err := V4CompatID.Verify(&r, nil); err != nil {
+		t.Fatalf("v4compat should accept an unsigned record: %v", err)
+	}
+	if err := V4ID.Verify(&r, nil); err == nil {
+		t.Fatalf("plain v4 should reject an unsigned record")
+	}
+}
+
+// TestV4CompatIDSigned checks that the "v4compat" scheme still enforces the signature
+// when one is present.
+func TestV4CompatIDSigned(t *testing.T) {
+	privkey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var r Record
+	r.Set(UDP(30303))
+	if err := SignV4(&r, privkey); err != nil {
+		t.Fatalf("SignV4: %v", err)
+	}
+	if err := V4CompatID.Verify(&r, r.Signature()); err != nil {
+		t.Fatalf("v4compat should verify a correctly signed record: %v", err)
+	}
+	if err := V4CompatID.Verify(&r, []byte{1, 2, 3}); err == nil {
+		t.Fatalf("v4compat should reject a bad signature")
+	}
+}
+
+// TestNullIDNodeAddr checks that the "null" scheme always reports an empty node address.
+func TestNullIDNodeAddr(t *testing.T) {
+	var r Record
+	r.Set(ID("null"))
+	r.Set(UDP(30303))
+	if err := r.SetSig(NullID, []byte{}); err != nil {
+		t.Fatalf("SetSig: %v", err)
+	}
+	if addr := NullID.NodeAddr(&r); addr != nil {
+		t.Fatalf("NullID.NodeAddr = %x, want nil", addr)
+	}
+}
+
+// TestRegisterIdentitySchemeDuplicate checks that registering the same scheme name twice
+// panics instead of silently overwriting the existing scheme.
+func TestRegisterIdentitySchemeDuplicate(t *testing.T) {
+	const name = "test-duplicate-scheme"
+	RegisterIdentityScheme(name, nullID{})
+	defer func() {
+		recover()
+	}()
+	RegisterIdentityScheme(name, nullID{})
+	t.Fatalf("RegisterIdentityScheme did not panic on duplicate name %q", name)
+}
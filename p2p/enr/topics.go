@@ -0,0 +1,148 @@
+// Copyright 2023 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package enr
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// Limits on the "topics" entry. These keep a record's topic list from growing the record
+// past the 300-byte size limit imposed by the discovery wire protocol (EIP-778), after
+// accounting for the space every signed v4 record needs regardless of its topics:
+//
+//	signature ("sig")        ~68 bytes (string header + up to 65-byte secp256k1 sig)
+//	seq                       ~9 bytes (rlp uint64, worst case)
+//	"id"      = "v4compat"   ~14 bytes (key + value headers and content)
+//	"secp256k1" pubkey       ~46 bytes (key + compressed pubkey headers and content)
+//	outer list + misc        ~15 bytes (list length header, per-entry key headers)
+//
+// which reserves recordOverhead bytes, leaving maxTopicsListSize for the "topics" entry
+// itself (its own key header, the list-of-strings header, and the entries).
+const (
+	maxRecordSize     = 300
+	recordOverhead    = 68 + 9 + 14 + 46 + 15          // = 152
+	maxTopicsListSize = maxRecordSize - recordOverhead // = 148
+
+	// perTopicOverhead bounds the rlp string length header each topic entry costs on top
+	// of its own bytes. For topics of at most maxTopicSize bytes that header is 1 byte, so
+	// this over-counts by one byte a topic.
+	perTopicOverhead = 2
+
+	maxTopics    = 4
+	maxTopicSize = 24
+)
+
+func init() {
+	if maxTopics*(maxTopicSize+perTopicOverhead) > maxTopicsListSize {
+		panic("enr: maxTopics/maxTopicSize exceed the topics entry's wire-size budget")
+	}
+}
+
+// ErrRecordTooBig is returned when modifying a record would grow it past the maximum
+// record size allowed by the discovery wire protocol.
+var ErrRecordTooBig = errors.New("enr: record would exceed maximum size")
+
+// Topics is the "topics" key, which holds the list of discv5 topics advertised by the
+// node.
+type Topics []string
+
+func (v Topics) ENRKey() string { return "topics" }
+
+// checkValid reports whether the topic list satisfies the "topics" entry's size limits.
+func (v Topics) checkValid() error {
+	if len(v) > maxTopics {
+		return fmt.Errorf("enr: too many topics, want at most %d, got %d", maxTopics, len(v))
+	}
+	for _, t := range v {
+		if len(t) == 0 || len(t) > maxTopicSize {
+			return fmt.Errorf("enr: topic %q must be between 1 and %d bytes", t, maxTopicSize)
+		}
+	}
+	return nil
+}
+
+// EncodeRLP implements rlp.Encoder. It rejects a topic list that violates the "topics"
+// entry's size limits so an invalid value can never be stored in a record, however it
+// was set.
+func (v Topics) EncodeRLP(w io.Writer) error {
+	if err := v.checkValid(); err != nil {
+		return err
+	}
+	return rlp.Encode(w, []string(v))
+}
+
+// DecodeRLP implements rlp.Decoder.
+func (v *Topics) DecodeRLP(s *rlp.Stream) error {
+	var list []string
+	if err := s.Decode(&list); err != nil {
+		return err
+	}
+	topics := Topics(list)
+	if err := topics.checkValid(); err != nil {
+		return err
+	}
+	*v = topics
+	return nil
+}
+
+// TopicRadius is the "topic-radius" key, which holds the Kademlia-distance radius used
+// by the node to answer discv5 topic queries.
+type TopicRadius uint64
+
+func (v TopicRadius) ENRKey() string { return "topic-radius" }
+
+// HasTopic reports whether the record advertises the given topic.
+func HasTopic(r *Record, name string) bool {
+	var topics Topics
+	if err := r.Load(&topics); err != nil {
+		return false
+	}
+	for _, t := range topics {
+		if t == name {
+			return true
+		}
+	}
+	return false
+}
+
+// AddTopic adds a topic to the record's "topics" entry, loading and storing it in place
+// with r.Set. The record is not re-signed. It returns ErrRecordTooBig if adding the
+// topic would grow the resulting "topics" entry past its size limit.
+func AddTopic(r *Record, name string) error {
+	var topics Topics
+	if err := r.Load(&topics); err != nil && !IsNotFound(err) {
+		return err
+	}
+	for _, t := range topics {
+		if t == name {
+			return nil
+		}
+	}
+	topics = append(topics, name)
+	if err := topics.checkValid(); err != nil {
+		if name == "" {
+			return err
+		}
+		return ErrRecordTooBig
+	}
+	r.Set(topics)
+	return nil
+}
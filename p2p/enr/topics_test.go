@@ -0,0 +1,129 @@
+// Copyright 2023 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package enr
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+func TestTopicsRoundtrip(t *testing.T) {
+	want := Topics{"foo", "bar"}
+	enc, err := rlp.EncodeToBytes(want)
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	var got Topics
+	if err := rlp.DecodeBytes(enc, &got); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestTopicsEncodeTooMany(t *testing.T) {
+	topics := make(Topics, maxTopics+1)
+	for i := range topics {
+		topics[i] = "t"
+	}
+	if _, err := rlp.EncodeToBytes(topics); err == nil {
+		t.Fatalf("expected error encoding more than %d topics", maxTopics)
+	}
+}
+
+func TestTopicsEncodeTooLong(t *testing.T) {
+	topics := Topics{strings.Repeat("x", maxTopicSize+1)}
+	if _, err := rlp.EncodeToBytes(topics); err == nil {
+		t.Fatalf("expected error encoding an oversized topic")
+	}
+}
+
+func TestTopicsDecodeTooMany(t *testing.T) {
+	list := make([]string, maxTopics+1)
+	for i := range list {
+		list[i] = "t"
+	}
+	enc, err := rlp.EncodeToBytes(list)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var v Topics
+	if err := rlp.DecodeBytes(enc, &v); err == nil {
+		t.Fatalf("expected error decoding more than %d topics", maxTopics)
+	}
+}
+
+func TestTopicsDecodeTooLong(t *testing.T) {
+	enc, err := rlp.EncodeToBytes([]string{strings.Repeat("x", maxTopicSize+1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var v Topics
+	if err := rlp.DecodeBytes(enc, &v); err == nil {
+		t.Fatalf("expected error decoding an oversized topic")
+	}
+}
+
+func TestHasTopicAndAddTopic(t *testing.T) {
+	var r Record
+	if HasTopic(&r, "foo") {
+		t.Fatalf("fresh record should not have any topics")
+	}
+	if err := AddTopic(&r, "foo"); err != nil {
+		t.Fatalf("AddTopic: %v", err)
+	}
+	if !HasTopic(&r, "foo") {
+		t.Fatalf("expected record to have topic %q after AddTopic", "foo")
+	}
+	if err := AddTopic(&r, "foo"); err != nil {
+		t.Fatalf("AddTopic should be idempotent: %v", err)
+	}
+}
+
+// TestTopicsWithinRecordBudget checks that a maximal "topics" entry (maxTopics entries of
+// maxTopicSize bytes each) actually fits within the space topics.go reserves for it,
+// i.e. that the limits are derived from a real wire-size budget and not an arbitrary
+// heuristic.
+func TestTopicsWithinRecordBudget(t *testing.T) {
+	full := make(Topics, maxTopics)
+	for i := range full {
+		full[i] = strings.Repeat("x", maxTopicSize)
+	}
+	enc, err := rlp.EncodeToBytes(full)
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	if len(enc) > maxTopicsListSize {
+		t.Fatalf("encoded maximal topics entry is %d bytes, want at most %d (recordOverhead=%d, maxRecordSize=%d)",
+			len(enc), maxTopicsListSize, recordOverhead, maxRecordSize)
+	}
+}
+
+func TestAddTopicOverflow(t *testing.T) {
+	var r Record
+	for i := 0; i < maxTopics; i++ {
+		if err := AddTopic(&r, strings.Repeat("t", 1)+string(rune('a'+i))); err != nil {
+			t.Fatalf("AddTopic %d: %v", i, err)
+		}
+	}
+	if err := AddTopic(&r, "overflow"); err != ErrRecordTooBig {
+		t.Fatalf("AddTopic past the limit: got %v, want ErrRecordTooBig", err)
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright 2023 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package enr
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// WebTransport is the "wt" key, which holds the UDP port serving WebTransport, letting
+// browser-based light clients reach the node over unauthenticated QUIC.
+type WebTransport uint16
+
+func (v WebTransport) ENRKey() string { return "wt" }
+
+// WebTransportCert is the "wtcert" key, which holds the SHA-256 hash of the
+// serverCertificateHash browsers use to validate the WebTransport connection's
+// self-signed certificate.
+type WebTransportCert [32]byte
+
+func (v WebTransportCert) ENRKey() string { return "wtcert" }
+
+// EncodeRLP implements rlp.Encoder.
+func (v WebTransportCert) EncodeRLP(w io.Writer) error {
+	return rlp.Encode(w, v[:])
+}
+
+// DecodeRLP implements rlp.Decoder.
+func (v *WebTransportCert) DecodeRLP(s *rlp.Stream) error {
+	buf, err := s.Bytes()
+	if err != nil {
+		return err
+	}
+	if len(buf) != len(v) {
+		return fmt.Errorf("invalid webtransport cert hash, want %d bytes: %v", len(v), buf)
+	}
+	copy(v[:], buf)
+	return nil
+}
+
+// WebRTC is the "webrtc" key, which holds the address of a libp2p-style WebRTC-direct
+// endpoint the node answers dial requests on.
+type WebRTC struct {
+	Port        uint16
+	Fingerprint [32]byte
+}
+
+func (v WebRTC) ENRKey() string { return "webrtc" }
+
+// EncodeRLP implements rlp.Encoder.
+func (v WebRTC) EncodeRLP(w io.Writer) error {
+	return rlp.Encode(w, []interface{}{v.Port, v.Fingerprint[:]})
+}
+
+// DecodeRLP implements rlp.Decoder.
+func (v *WebRTC) DecodeRLP(s *rlp.Stream) error {
+	var list struct {
+		Port        uint16
+		Fingerprint []byte
+	}
+	if err := s.Decode(&list); err != nil {
+		return err
+	}
+	if len(list.Fingerprint) != len(v.Fingerprint) {
+		return fmt.Errorf("invalid webrtc fingerprint, want %d bytes: %v", len(v.Fingerprint), list.Fingerprint)
+	}
+	v.Port = list.Port
+	copy(v.Fingerprint[:], list.Fingerprint)
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright 2023 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package enr
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+func TestWebTransportCertRoundtrip(t *testing.T) {
+	var want WebTransportCert
+	for i := range want {
+		want[i] = byte(i)
+	}
+	enc, err := rlp.EncodeToBytes(want)
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	var got WebTransportCert
+	if err := rlp.DecodeBytes(enc, &got); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if got != want {
+		t.Fatalf("got %x, want %x", got, want)
+	}
+}
+
+func TestWebTransportCertDecodeWrongSize(t *testing.T) {
+	enc, _ := rlp.EncodeToBytes([]byte{1, 2, 3})
+	var v WebTransportCert
+	if err := rlp.DecodeBytes(enc, &v); err == nil {
+		t.Fatalf("expected error decoding undersized cert hash")
+	}
+}
+
+func TestWebRTCRoundtrip(t *testing.T) {
+	want := WebRTC{Port: 9000}
+	for i := range want.Fingerprint {
+		want.Fingerprint[i] = byte(i)
+	}
+	enc, err := rlp.EncodeToBytes(want)
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	var got WebRTC
+	if err := rlp.DecodeBytes(enc, &got); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if got != want {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestWebRTCDecodeWrongFingerprintSize(t *testing.T) {
+	enc, err := rlp.EncodeToBytes([]interface{}{uint16(9000), []byte{1, 2, 3}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var v WebRTC
+	if err := rlp.DecodeBytes(enc, &v); err == nil {
+		t.Fatalf("expected error decoding undersized fingerprint")
+	}
+}